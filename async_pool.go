@@ -0,0 +1,211 @@
+package eventemitter
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// OnQueueFull determines what submitting a job to a full worker pool queue does.
+type OnQueueFull int
+
+const (
+	// QueueBlock blocks the calling goroutine until the job queue has room.
+	QueueBlock OnQueueFull = iota
+	// QueueDrop drops the job immediately if the queue is full.
+	QueueDrop
+)
+
+// defaultQueueSizeMultiplier is used to size the job queue relative to the worker count when
+// QueueSize is left at its zero value.
+const defaultQueueSizeMultiplier = 4
+
+// Options configures an Emitter constructed via NewEmitterWithOptions.
+type Options struct {
+	// Async determines whether listeners fire in separate goroutines or not.
+	Async bool
+	// Workers is the number of worker goroutines used to run async handlers. Defaults to
+	// runtime.NumCPU() when Async is true and Workers is zero.
+	Workers int
+	// QueueSize is the capacity of the job queue feeding the worker pool. Defaults to
+	// Workers * 4 when left at zero.
+	QueueSize int
+	// OnQueueFull determines what happens when a handler is dispatched and the job queue is full.
+	OnQueueFull OnQueueFull
+}
+
+// NewEmitterWithOptions creates a new Emitter whose async dispatch, if enabled, runs on a bounded
+// worker pool instead of spawning one goroutine per handler per emit.
+func NewEmitterWithOptions(opts Options) (em *Emitter) {
+	em = &Emitter{
+		async:         opts.Async,
+		listeners:     make(map[EventType][]*Listener),
+		listenersOnce: make(map[EventType][]*Listener),
+		onQueueFull:   opts.OnQueueFull,
+	}
+
+	if !opts.Async {
+		return em
+	}
+
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	queueSize := opts.QueueSize
+	if queueSize <= 0 {
+		queueSize = workers * defaultQueueSizeMultiplier
+	}
+
+	em.workerCount = workers
+	em.jobs = make(chan func(), queueSize)
+	em.startWorkers()
+	return em
+}
+
+func (em *Emitter) startWorkers() {
+	for i := 0; i < em.workerCount; i++ {
+		em.workersWG.Add(1)
+		go em.worker()
+	}
+}
+
+func (em *Emitter) worker() {
+	defer em.workersWG.Done()
+
+	for job := range em.jobs {
+		atomic.AddInt32(&em.activeWorkers, 1)
+		job()
+		atomic.AddInt32(&em.activeWorkers, -1)
+	}
+}
+
+// submitJob hands fn to the worker pool, honouring the configured OnQueueFull policy, and reports
+// whether fn was actually enqueued. It refuses to enqueue (returning false) once the emitter has been
+// shut down, or under QueueDrop when the queue is full; callers must not assume fn will ever run.
+//
+// Holding jobsMu for read across the whole check-and-send excludes a concurrent Shutdown, which holds
+// it for write, so a submit can never race the close of em.jobs.
+func (em *Emitter) submitJob(fn func()) (enqueued bool) {
+	em.jobsMu.RLock()
+	defer em.jobsMu.RUnlock()
+
+	if em.closed {
+		return false
+	}
+
+	if em.onQueueFull == QueueDrop {
+		select {
+		case em.jobs <- fn:
+			return true
+		default:
+			// Queue is full, drop the job.
+			return false
+		}
+	}
+
+	em.jobs <- fn
+	return true
+}
+
+// QueuedJobs returns the number of jobs currently waiting in the worker pool's queue.
+func (em *Emitter) QueuedJobs() int {
+	if em.jobs == nil {
+		return 0
+	}
+	return len(em.jobs)
+}
+
+// ActiveWorkers returns the number of worker goroutines currently executing a handler.
+func (em *Emitter) ActiveWorkers() int {
+	return int(atomic.LoadInt32(&em.activeWorkers))
+}
+
+// Shutdown stops accepting new jobs and waits for the worker pool to drain the queue and finish any
+// in-flight handlers, or for ctx to be done, whichever comes first. It is a no-op for emitters that
+// were not constructed with a worker pool, and safe to call more than once.
+func (em *Emitter) Shutdown(ctx context.Context) error {
+	if em.jobs == nil {
+		return nil
+	}
+
+	em.jobsMu.Lock()
+	if em.closed {
+		em.jobsMu.Unlock()
+		return nil
+	}
+	em.closed = true
+	close(em.jobs)
+	em.jobsMu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		em.workersWG.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// EmitEventAndWait emits the given event and blocks until every matching listener and capturer has
+// returned, instead of the caller having to guess a sleep duration for async dispatch to settle.
+func (em *Emitter) EmitEventAndWait(event EventType, arguments ...interface{}) {
+	var wg sync.WaitGroup
+
+	dispatch := func(listeners []*Listener) {
+		for _, listener := range listeners {
+			listener := listener
+			wg.Add(1)
+			em.runAndWait(&wg, func() { listener.handler(arguments...) })
+		}
+	}
+
+	em.mu.Lock()
+	onceListeners := em.listenersOnce[event]
+	em.listenersOnce[event] = make([]*Listener, 0)
+	em.mu.Unlock()
+	dispatch(onceListeners)
+
+	em.mu.RLock()
+	listeners := em.listeners[event]
+	em.mu.RUnlock()
+	dispatch(listeners)
+
+	dispatch(em.takeMatchingOncePatterns(event))
+	dispatch(em.matchingPatterns(event))
+
+	em.mu.RLock()
+	capturers := em.capturers
+	em.mu.RUnlock()
+	for _, capturer := range capturers {
+		capturer := capturer
+		wg.Add(1)
+		em.runAndWait(&wg, func() { capturer.handler(event, arguments...) })
+	}
+
+	wg.Wait()
+}
+
+// runAndWait runs fn on the worker pool when one is configured, or in its own goroutine otherwise,
+// calling wg.Done once fn returns. If the pool refuses the job (a full QueueDrop queue, or a
+// Shutdown emitter), fn never runs and wg.Done is called immediately so EmitEventAndWait's Wait
+// cannot block forever on it.
+func (em *Emitter) runAndWait(wg *sync.WaitGroup, fn func()) {
+	job := func() {
+		defer wg.Done()
+		fn()
+	}
+	if em.jobs != nil {
+		if !em.submitJob(job) {
+			wg.Done()
+		}
+		return
+	}
+	go job()
+}
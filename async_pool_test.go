@@ -0,0 +1,230 @@
+package eventemitter
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestEmitEventAndWait(t *testing.T) {
+	e := NewEmitterWithOptions(Options{Async: true, Workers: 2, QueueSize: 4})
+
+	var fired int32
+	e.AddListener("test event", func(args ...interface{}) {
+		time.Sleep(time.Millisecond * 20)
+		atomic.AddInt32(&fired, 1)
+	})
+	e.AddListener("test event", func(args ...interface{}) {
+		atomic.AddInt32(&fired, 1)
+	})
+
+	e.EmitEventAndWait("test event", 1)
+
+	if atomic.LoadInt32(&fired) != 2 {
+		t.Fatalf("expected both handlers to have completed by the time EmitEventAndWait returns, got %d", fired)
+	}
+}
+
+func TestEmitEventAndWaitWithoutPool(t *testing.T) {
+	e := NewEmitter(true)
+
+	var fired int32
+	e.AddListener("test event", func(args ...interface{}) {
+		time.Sleep(time.Millisecond * 20)
+		atomic.AddInt32(&fired, 1)
+	})
+
+	e.EmitEventAndWait("test event", 1)
+
+	if atomic.LoadInt32(&fired) != 1 {
+		t.Fatalf("expected handler to have completed by the time EmitEventAndWait returns, got %d", fired)
+	}
+}
+
+func TestWorkerPoolBoundsGoroutines(t *testing.T) {
+	e := NewEmitterWithOptions(Options{Async: true, Workers: 2, QueueSize: 8})
+
+	var active, maxActive int32
+	e.AddListener("test event", func(args ...interface{}) {
+		n := atomic.AddInt32(&active, 1)
+		for {
+			cur := atomic.LoadInt32(&maxActive)
+			if n <= cur || atomic.CompareAndSwapInt32(&maxActive, cur, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond * 50)
+		atomic.AddInt32(&active, -1)
+	})
+
+	for i := 0; i < 6; i++ {
+		e.EmitEvent("test event", i)
+	}
+
+	time.Sleep(time.Millisecond * 500)
+
+	if got := atomic.LoadInt32(&maxActive); got > 2 {
+		t.Fatalf("expected at most 2 concurrent handlers with 2 workers, saw %d", got)
+	}
+}
+
+func TestEmitterMetrics(t *testing.T) {
+	e := NewEmitterWithOptions(Options{Async: true, Workers: 1, QueueSize: 4})
+
+	release := make(chan struct{})
+	e.AddListener("test event", func(args ...interface{}) {
+		<-release
+	})
+
+	e.EmitEvent("test event")
+	e.EmitEvent("test event")
+	time.Sleep(time.Millisecond * 50)
+
+	if e.ActiveWorkers() != 1 {
+		t.Fatalf("expected 1 active worker, got %d", e.ActiveWorkers())
+	}
+	if e.QueuedJobs() != 1 {
+		t.Fatalf("expected 1 queued job, got %d", e.QueuedJobs())
+	}
+
+	close(release)
+}
+
+func TestEmitterShutdownDrainsQueue(t *testing.T) {
+	e := NewEmitterWithOptions(Options{Async: true, Workers: 1, QueueSize: 4})
+
+	var processed int32
+	e.AddListener("test event", func(args ...interface{}) {
+		time.Sleep(time.Millisecond * 10)
+		atomic.AddInt32(&processed, 1)
+	})
+
+	for i := 0; i < 3; i++ {
+		e.EmitEvent("test event")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := e.Shutdown(ctx); err != nil {
+		t.Fatalf("unexpected shutdown error: %v", err)
+	}
+
+	if atomic.LoadInt32(&processed) != 3 {
+		t.Fatalf("expected all queued jobs to be drained before Shutdown returns, got %d", processed)
+	}
+}
+
+func TestEmitterQueueDropPolicy(t *testing.T) {
+	e := NewEmitterWithOptions(Options{Async: true, Workers: 1, QueueSize: 1, OnQueueFull: QueueDrop})
+
+	release := make(chan struct{})
+	var processed int32
+	e.AddListener("test event", func(args ...interface{}) {
+		<-release
+		atomic.AddInt32(&processed, 1)
+	})
+
+	for i := 0; i < 5; i++ {
+		e.EmitEvent("test event")
+	}
+	close(release)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	_ = e.Shutdown(ctx)
+
+	if atomic.LoadInt32(&processed) >= 5 {
+		t.Fatalf("expected QueueDrop to drop some jobs under a full queue, processed %d", processed)
+	}
+}
+
+func TestEmitEventAndWaitQueueDropDoesNotHang(t *testing.T) {
+	e := NewEmitterWithOptions(Options{Async: true, Workers: 1, QueueSize: 1, OnQueueFull: QueueDrop})
+
+	release := make(chan struct{})
+	e.AddListener("test event", func(args ...interface{}) {
+		<-release
+	})
+	for i := 0; i < 5; i++ {
+		e.AddListener("test event", func(args ...interface{}) {})
+	}
+
+	done := make(chan struct{})
+	go func() {
+		e.EmitEventAndWait("test event", 1)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		t.Fatal("expected EmitEventAndWait to still be blocked on the listener holding up the single worker")
+	case <-time.After(time.Millisecond * 100):
+	}
+
+	close(release)
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("EmitEventAndWait never returned: a dropped job's wg.Done was not called")
+	}
+}
+
+func TestEmitEventConcurrentWithShutdownDoesNotPanic(t *testing.T) {
+	for i := 0; i < 50; i++ {
+		e := NewEmitterWithOptions(Options{Async: true, Workers: 4, QueueSize: 4})
+		e.AddListener("test event", func(args ...interface{}) {})
+
+		var wg sync.WaitGroup
+		wg.Add(9)
+		for g := 0; g < 8; g++ {
+			go func() {
+				defer wg.Done()
+				for j := 0; j < 50; j++ {
+					e.EmitEvent("test event")
+				}
+			}()
+		}
+		go func() {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+			_ = e.Shutdown(ctx)
+		}()
+
+		wg.Wait()
+	}
+}
+
+func TestEmitEventAndWaitAfterShutdown(t *testing.T) {
+	e := NewEmitterWithOptions(Options{Async: true, Workers: 1, QueueSize: 1})
+
+	fired := false
+	e.AddListener("test event", func(args ...interface{}) {
+		fired = true
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := e.Shutdown(ctx); err != nil {
+		t.Fatalf("unexpected shutdown error: %v", err)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		e.EmitEventAndWait("test event", 1)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("EmitEventAndWait never returned after Shutdown: a refused job's wg.Done was not called")
+	}
+
+	if fired {
+		t.Fatal("expected the listener not to run once the emitter was shut down")
+	}
+}
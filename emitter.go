@@ -1,6 +1,7 @@
 package eventemitter
 
 import (
+	"sort"
 	"sync"
 )
 
@@ -11,6 +12,27 @@ type Emitter struct {
 	capturers     []*Capturer
 	listeners     map[EventType][]*Listener
 	listenersOnce map[EventType][]*Listener
+	patterns      []*patternEntry
+	patternsOnce  []*patternEntry
+
+	// Worker pool used for async dispatch when the emitter was constructed via NewEmitterWithOptions.
+	// jobs is nil for emitters created with NewEmitter, which fall back to one goroutine per dispatch.
+	workerCount int
+	onQueueFull OnQueueFull
+	jobs        chan func()
+
+	// jobsMu guards closed against a concurrent Shutdown: submitJob holds it for read for its whole
+	// check-and-send so Shutdown (holding it for write) can never close jobs out from under a send.
+	jobsMu        sync.RWMutex
+	closed        bool
+	activeWorkers int32
+	workersWG     sync.WaitGroup
+
+	// Ext is ready-to-use, per-emitter storage for extension packages (such as typed) that need to
+	// attach their own state to an Emitter. Keying off this instead of a package-global registry ties
+	// the state's lifetime to the Emitter's, so it is freed along with the Emitter rather than leaking
+	// for as long as the process runs.
+	Ext sync.Map
 }
 
 // NewEmitter creates a new event emitter that implements the Observable interface.
@@ -51,6 +73,10 @@ func (em *Emitter) EmitEvent(event EventType, arguments ...interface{}) {
 	}
 	em.mu.RUnlock()
 
+	// Walk the registered patterns after exact-match listeners have fired
+	em.emitPatternOnceEvents(event, arguments)
+	em.emitPatternEvents(event, arguments)
+
 	em.mu.RLock()
 	// If we have no capturers, skip
 	if len(em.capturers) > 0 {
@@ -61,16 +87,28 @@ func (em *Emitter) EmitEvent(event EventType, arguments ...interface{}) {
 
 func (em *Emitter) emitListenerEvents(listeners []*Listener, arguments []interface{}) {
 	for _, listener := range listeners {
+		listener := listener
+		if em.jobs != nil {
+			em.submitJob(func() { listener.handler(arguments...) })
+			continue
+		}
 		if em.async {
 			go listener.handler(arguments...)
 			continue
 		}
-		listener.handler(arguments...)
+		if listener.handler(arguments...) == StopPropagation {
+			return
+		}
 	}
 }
 
 func (em *Emitter) emitCapturerEvents(capturers []*Capturer, event EventType, arguments []interface{}) {
 	for _, capturer := range capturers {
+		capturer := capturer
+		if em.jobs != nil {
+			em.submitJob(func() { capturer.handler(event, arguments...) })
+			continue
+		}
 		if em.async {
 			go capturer.handler(event, arguments...)
 			continue
@@ -81,13 +119,25 @@ func (em *Emitter) emitCapturerEvents(capturers []*Capturer, event EventType, ar
 
 // AddListener adds a listener for the given event type
 func (em *Emitter) AddListener(event EventType, handler HandleFunc) (listener *Listener) {
+	return em.AddListenerWithPriority(event, 0, wrapHandleFunc(handler))
+}
+
+// AddListenerWithPriority adds a listener for the given event type with the given priority. Listeners
+// with a higher priority are called first; for equal priorities, registration order is preserved. If
+// the handler returns StopPropagation, dispatch to the remaining listeners for this event is aborted
+// (capturers still fire). This only has a well-defined effect for EmitEvent on emitters running in sync
+// mode, since async listeners are already dispatched concurrently by the time one of them returns.
+// EmitEventAndWait dispatches every listener concurrently, even on a sync emitter, and ignores the result.
+func (em *Emitter) AddListenerWithPriority(event EventType, priority int, handler PriorityHandleFunc) (listener *Listener) {
 	em.mu.Lock()
 	defer em.mu.Unlock()
 
 	listener = &Listener{
-		handler: handler,
+		priority: priority,
+		handler:  handler,
 	}
 	em.listeners[event] = append(em.listeners[event], listener)
+	sortListenersByPriority(em.listeners[event])
 	return listener
 }
 
@@ -97,12 +147,20 @@ func (em *Emitter) ListenOnce(event EventType, handler HandleFunc) (listener *Li
 	defer em.mu.Unlock()
 
 	listener = &Listener{
-		handler: handler,
+		handler: wrapHandleFunc(handler),
 	}
 	em.listenersOnce[event] = append(em.listenersOnce[event], listener)
 	return listener
 }
 
+// sortListenersByPriority stable-sorts listeners by descending priority, so listeners with equal
+// priority keep their relative insertion order.
+func sortListenersByPriority(listeners []*Listener) {
+	sort.SliceStable(listeners, func(i, j int) bool {
+		return listeners[i].priority > listeners[j].priority
+	})
+}
+
 // AddCapturer adds an event capturer for all events
 func (em *Emitter) AddCapturer(handler CaptureFunc) (capturer *Capturer) {
 	em.mu.Lock()
@@ -0,0 +1,69 @@
+package eventemitter
+
+import "sync"
+
+// cachedEvent is a single buffered emit, recorded until the cache is flushed.
+type cachedEvent struct {
+	event     EventType
+	arguments []interface{}
+}
+
+// EventCache wraps an EventEmitter and buffers emitted events instead of dispatching them immediately.
+// This is useful when a caller wants to compute a batch of state changes and only publish them once
+// e.g. a transaction commits.
+type EventCache struct {
+	mu       sync.Mutex
+	emitter  EventEmitter
+	buffered []cachedEvent
+}
+
+// NewEventCache creates a new EventCache wrapping the given emitter, with room for initialCapacity
+// buffered events before the backing slice needs to grow.
+func NewEventCache(emitter EventEmitter, initialCapacity int) (ec *EventCache) {
+	return &EventCache{
+		emitter:  emitter,
+		buffered: make([]cachedEvent, 0, initialCapacity),
+	}
+}
+
+// EmitEvent buffers the given event and arguments instead of dispatching them to the wrapped emitter.
+// Call Flush to forward the buffered events in FIFO order, or Discard to drop them.
+func (ec *EventCache) EmitEvent(event EventType, arguments ...interface{}) {
+	ec.mu.Lock()
+	defer ec.mu.Unlock()
+
+	ec.buffered = append(ec.buffered, cachedEvent{
+		event:     event,
+		arguments: arguments,
+	})
+}
+
+// Flush drains the buffer and forwards each buffered event to the wrapped emitter in FIFO order.
+// It is safe to call concurrently with further Emits; any events buffered during the flush remain
+// buffered for a subsequent Flush.
+func (ec *EventCache) Flush() {
+	ec.mu.Lock()
+	buffered := ec.buffered
+	ec.buffered = make([]cachedEvent, 0, cap(buffered))
+	ec.mu.Unlock()
+
+	for _, cached := range buffered {
+		ec.emitter.EmitEvent(cached.event, cached.arguments...)
+	}
+}
+
+// Discard clears the buffer without firing any of the buffered events.
+func (ec *EventCache) Discard() {
+	ec.mu.Lock()
+	defer ec.mu.Unlock()
+
+	ec.buffered = ec.buffered[:0]
+}
+
+// Len returns the number of events currently buffered.
+func (ec *EventCache) Len() int {
+	ec.mu.Lock()
+	defer ec.mu.Unlock()
+
+	return len(ec.buffered)
+}
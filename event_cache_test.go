@@ -0,0 +1,139 @@
+package eventemitter
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestEventCacheOrdering(t *testing.T) {
+	e := NewEmitter(false)
+	ec := NewEventCache(e, 4)
+
+	var order []int
+	e.AddListener("test event", func(args ...interface{}) {
+		order = append(order, args[0].(int))
+	})
+
+	ec.EmitEvent("test event", 1)
+	ec.EmitEvent("test event", 2)
+	ec.EmitEvent("test event", 3)
+
+	if ec.Len() != 3 {
+		t.Fatalf("expected 3 buffered events, got %d", ec.Len())
+	}
+	if len(order) != 0 {
+		t.Fatalf("expected no events dispatched before Flush, got %d", len(order))
+	}
+
+	ec.Flush()
+
+	if ec.Len() != 0 {
+		t.Fatalf("expected buffer to be empty after Flush, got %d", ec.Len())
+	}
+	if len(order) != 3 || order[0] != 1 || order[1] != 2 || order[2] != 3 {
+		t.Fatalf("expected events to be flushed in FIFO order, got %v", order)
+	}
+}
+
+func TestEventCacheDiscard(t *testing.T) {
+	e := NewEmitter(false)
+	ec := NewEventCache(e, 0)
+
+	fired := false
+	e.AddListener("test event", func(args ...interface{}) {
+		fired = true
+	})
+
+	ec.EmitEvent("test event", 1)
+	ec.Discard()
+	ec.Flush()
+
+	if ec.Len() != 0 {
+		t.Fatalf("expected buffer to be empty after Discard, got %d", ec.Len())
+	}
+	if fired {
+		t.Fatal("expected discarded event to never fire")
+	}
+}
+
+func TestEventCacheBufferingContinuesAfterFlush(t *testing.T) {
+	e := NewEmitter(false)
+	ec := NewEventCache(e, 0)
+
+	var order []int
+	e.AddListener("test event", func(args ...interface{}) {
+		order = append(order, args[0].(int))
+	})
+
+	ec.EmitEvent("test event", 1)
+	ec.Flush()
+	// Buffering continues after a Flush
+	ec.EmitEvent("test event", 2)
+
+	if ec.Len() != 1 {
+		t.Fatalf("expected 1 buffered event after further Emit, got %d", ec.Len())
+	}
+
+	ec.Flush()
+
+	if len(order) != 2 || order[0] != 1 || order[1] != 2 {
+		t.Fatalf("expected both flushes to dispatch in order, got %v", order)
+	}
+}
+
+func TestEventCacheFlushConcurrentWithEmit(t *testing.T) {
+	e := NewEmitter(false)
+	ec := NewEventCache(e, 0)
+
+	var received int32
+	e.AddListener("test event", func(args ...interface{}) {
+		atomic.AddInt32(&received, 1)
+	})
+
+	const goroutines = 8
+	const emitsPerGoroutine = 50
+
+	var wg sync.WaitGroup
+	wg.Add(goroutines * 2)
+
+	for i := 0; i < goroutines; i++ {
+		go func() {
+			defer wg.Done()
+			for j := 0; j < emitsPerGoroutine; j++ {
+				ec.EmitEvent("test event", j)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < emitsPerGoroutine; j++ {
+				ec.Flush()
+			}
+		}()
+	}
+
+	wg.Wait()
+	ec.Flush()
+
+	if atomic.LoadInt32(&received) != goroutines*emitsPerGoroutine {
+		t.Fatalf("expected every buffered event to be delivered exactly once, got %d", received)
+	}
+}
+
+func TestEventCacheOnceListenerAcrossFlush(t *testing.T) {
+	e := NewEmitter(false)
+	ec := NewEventCache(e, 0)
+
+	fired := 0
+	e.ListenOnce("test event", func(args ...interface{}) {
+		fired++
+	})
+
+	ec.EmitEvent("test event", 1)
+	ec.EmitEvent("test event", 2)
+	ec.Flush()
+
+	if fired != 1 {
+		t.Fatalf("expected once-listener to fire exactly once across a Flush, got %d", fired)
+	}
+}
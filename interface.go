@@ -5,9 +5,35 @@ type EventType string
 // HandleFunc is a handler function for a given event type
 type HandleFunc func(arguments ...interface{})
 
+// Result is returned by a PriorityHandleFunc to control dispatch of remaining listeners for the event.
+type Result int
+
+const (
+	// Continue lets dispatch proceed to the remaining listeners for the event.
+	Continue Result = iota
+	// StopPropagation aborts dispatch to the remaining listeners for the event. Capturers still fire.
+	// This is only meaningful for emitters running in sync mode; in async mode listeners are already
+	// dispatched concurrently, so the result of one cannot stop another. EmitEventAndWait also dispatches
+	// concurrently, so it ignores StopPropagation in either mode.
+	StopPropagation
+)
+
+// PriorityHandleFunc is a handler function registered with AddListenerWithPriority. Its Result
+// determines whether dispatch continues to the remaining listeners for the event.
+type PriorityHandleFunc func(arguments ...interface{}) Result
+
 // Listener is a container struct used to remove the listener
 type Listener struct {
-	handler HandleFunc
+	priority int
+	handler  PriorityHandleFunc
+}
+
+// wrapHandleFunc adapts a plain HandleFunc to a PriorityHandleFunc that always continues propagation.
+func wrapHandleFunc(handler HandleFunc) PriorityHandleFunc {
+	return func(arguments ...interface{}) Result {
+		handler(arguments...)
+		return Continue
+	}
 }
 
 // CaptureFunc is a capturer function that can capture all emitted events
@@ -24,15 +50,32 @@ type Observable interface {
 	AddListener(event EventType, handler HandleFunc) (listener *Listener)
 	// ListenOnce adds a listener for the given event type that removes itself after it has been fired once
 	ListenOnce(event EventType, handler HandleFunc) (listener *Listener)
+	// AddListenerWithPriority adds a listener for the given event type with the given priority. Higher
+	// priorities run first; the handler's Result controls whether dispatch continues to lower priority
+	// listeners for the same event.
+	AddListenerWithPriority(event EventType, priority int, handler PriorityHandleFunc) (listener *Listener)
+	// AddListenerPattern adds a listener for a dot-delimited pattern (e.g. "server.*.log" or "server.#")
+	AddListenerPattern(pattern EventType, handler HandleFunc) (listener *Listener)
+	// ListenOncePattern adds a pattern listener that removes itself after it has been fired once
+	ListenOncePattern(pattern EventType, handler HandleFunc) (listener *Listener)
 	// AddCapturer adds an event capturer for all events
 	AddCapturer(handler CaptureFunc) (capturer *Capturer)
 	// RemoveListener removes the registered given listener for the given event
 	RemoveListener(event EventType, listener *Listener)
+	// RemoveListenerPattern removes the registered given pattern listener for the given pattern
+	RemoveListenerPattern(pattern EventType, listener *Listener)
 	// RemoveCapturer removes the given capturer
 	RemoveCapturer(capturer *Capturer)
+	// Subscribe returns a channel-based Subscription for the given event type
+	Subscribe(event EventType, buffer int, policy DropPolicy) (sub *Subscription)
+	// SubscribeAll returns a channel-based Subscription for all emitted events
+	SubscribeAll(buffer int, policy DropPolicy) (sub *Subscription)
 }
 
 type EventEmitter interface {
 	// EmitEvent emits the given event to all listeners and capturers
 	EmitEvent(event EventType, arguments ...interface{})
+	// EmitEventAndWait emits the given event and blocks until every matching listener and capturer
+	// has returned
+	EmitEventAndWait(event EventType, arguments ...interface{})
 }
@@ -0,0 +1,174 @@
+package eventemitter
+
+import "strings"
+
+// patternSeparator is the delimiter used to split event types into segments for pattern matching.
+const patternSeparator = "."
+
+// patternEntry is a container struct used to match and remove a pattern listener.
+type patternEntry struct {
+	pattern  EventType
+	segments []string
+	listener *Listener
+}
+
+// AddListenerPattern adds a listener for a dot-delimited pattern such as "server.*.log" or "server.#".
+// A "*" matches exactly one segment, a trailing "#" matches zero or more segments.
+func (em *Emitter) AddListenerPattern(pattern EventType, handler HandleFunc) (listener *Listener) {
+	em.mu.Lock()
+	defer em.mu.Unlock()
+
+	listener = &Listener{
+		handler: wrapHandleFunc(handler),
+	}
+	em.patterns = append(em.patterns, &patternEntry{
+		pattern:  pattern,
+		segments: splitPattern(pattern),
+		listener: listener,
+	})
+	return listener
+}
+
+// ListenOncePattern adds a pattern listener that removes itself after it has been fired once.
+func (em *Emitter) ListenOncePattern(pattern EventType, handler HandleFunc) (listener *Listener) {
+	em.mu.Lock()
+	defer em.mu.Unlock()
+
+	listener = &Listener{
+		handler: wrapHandleFunc(handler),
+	}
+	em.patternsOnce = append(em.patternsOnce, &patternEntry{
+		pattern:  pattern,
+		segments: splitPattern(pattern),
+		listener: listener,
+	})
+	return listener
+}
+
+// RemoveListenerPattern removes the registered given pattern listener for the given pattern
+func (em *Emitter) RemoveListenerPattern(pattern EventType, listener *Listener) {
+	em.mu.Lock()
+	defer em.mu.Unlock()
+
+	for index, entry := range em.patterns {
+		if entry.pattern == pattern && entry.listener == listener {
+			em.removePatternAt(index)
+			return
+		}
+	}
+
+	// If it hasn't been found yet, remove from the once patterns if present there
+	for index, entry := range em.patternsOnce {
+		if entry.pattern == pattern && entry.listener == listener {
+			em.removePatternOnceAt(index)
+			return
+		}
+	}
+}
+
+func (em *Emitter) removePatternAt(index int) {
+	copy(em.patterns[index:], em.patterns[index+1:])
+	em.patterns[len(em.patterns)-1] = nil
+	em.patterns = em.patterns[:len(em.patterns)-1]
+}
+
+func (em *Emitter) removePatternOnceAt(index int) {
+	copy(em.patternsOnce[index:], em.patternsOnce[index+1:])
+	em.patternsOnce[len(em.patternsOnce)-1] = nil
+	em.patternsOnce = em.patternsOnce[:len(em.patternsOnce)-1]
+}
+
+// emitPatternOnceEvents matches event against the registered once-patterns, removing any that match
+// before dispatching them, so each fires exactly once even under recursive emits.
+func (em *Emitter) emitPatternOnceEvents(event EventType, arguments []interface{}) {
+	matched := em.takeMatchingOncePatterns(event)
+	if len(matched) > 0 {
+		em.emitListenerEvents(matched, arguments)
+	}
+}
+
+// emitPatternEvents matches event against the registered patterns and dispatches the matching listeners
+func (em *Emitter) emitPatternEvents(event EventType, arguments []interface{}) {
+	matched := em.matchingPatterns(event)
+	if len(matched) > 0 {
+		em.emitListenerEvents(matched, arguments)
+	}
+}
+
+// takeMatchingOncePatterns removes and returns the once-pattern listeners that match event.
+func (em *Emitter) takeMatchingOncePatterns(event EventType) []*Listener {
+	em.mu.RLock()
+	if len(em.patternsOnce) == 0 {
+		em.mu.RUnlock()
+		return nil
+	}
+	em.mu.RUnlock()
+
+	eventSegments := splitPattern(event)
+
+	em.mu.Lock()
+	defer em.mu.Unlock()
+
+	matched := make([]*Listener, 0)
+	remaining := make([]*patternEntry, 0, len(em.patternsOnce))
+	for _, entry := range em.patternsOnce {
+		if matchPatternSegments(entry.segments, eventSegments) {
+			matched = append(matched, entry.listener)
+			continue
+		}
+		remaining = append(remaining, entry)
+	}
+	em.patternsOnce = remaining
+	return matched
+}
+
+// matchingPatterns returns the pattern listeners that match event, without removing them.
+func (em *Emitter) matchingPatterns(event EventType) []*Listener {
+	em.mu.RLock()
+	defer em.mu.RUnlock()
+
+	if len(em.patterns) == 0 {
+		return nil
+	}
+
+	eventSegments := splitPattern(event)
+	matched := make([]*Listener, 0)
+	for _, entry := range em.patterns {
+		if matchPatternSegments(entry.segments, eventSegments) {
+			matched = append(matched, entry.listener)
+		}
+	}
+	return matched
+}
+
+func splitPattern(event EventType) []string {
+	return strings.Split(string(event), patternSeparator)
+}
+
+// matchPatternSegments reports whether the given compiled pattern segments match the event segments.
+// "*" matches exactly one segment, a trailing "#" matches zero or more remaining segments.
+func matchPatternSegments(pattern, event []string) bool {
+	hasMultiWildcard := len(pattern) > 0 && pattern[len(pattern)-1] == "#"
+	prefix := pattern
+	if hasMultiWildcard {
+		prefix = pattern[:len(pattern)-1]
+	}
+
+	if hasMultiWildcard {
+		if len(event) < len(prefix) {
+			return false
+		}
+	} else if len(event) != len(prefix) {
+		return false
+	}
+
+	for i, segment := range prefix {
+		if segment == "*" {
+			continue
+		}
+		if segment != event[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,117 @@
+package eventemitter
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestEmitterPatternSynced(t *testing.T) {
+	testEmitterPattern(t, false)
+}
+
+func TestEmitterPatternAsync(t *testing.T) {
+	testEmitterPattern(t, true)
+}
+
+func testEmitterPattern(t *testing.T, async bool) {
+	e := NewEmitter(async)
+
+	var single, star, hash int32
+
+	onceListener := e.ListenOncePattern("server.*.log", func(args ...interface{}) {
+		atomic.AddInt32(&single, 1)
+	})
+	_ = onceListener
+
+	e.AddListenerPattern("server.*.log", func(args ...interface{}) {
+		atomic.AddInt32(&star, 1)
+	})
+
+	e.AddListenerPattern("server.#", func(args ...interface{}) {
+		atomic.AddInt32(&hash, 1)
+	})
+
+	e.EmitEvent("server.one.log")
+	e.EmitEvent("server.one.log")
+	e.EmitEvent("server.one.two.three")
+	e.EmitEvent("unrelated.event")
+
+	if async {
+		time.Sleep(time.Millisecond * 200)
+	}
+
+	if atomic.LoadInt32(&single) != 1 {
+		t.Errorf("expected once pattern listener to fire exactly once, got %d", single)
+	}
+	if atomic.LoadInt32(&star) != 2 {
+		t.Errorf("expected single-segment wildcard listener to fire twice, got %d", star)
+	}
+	if atomic.LoadInt32(&hash) != 3 {
+		t.Errorf("expected multi-segment wildcard listener to fire three times, got %d", hash)
+	}
+}
+
+func TestEmitterPatternRemove(t *testing.T) {
+	e := NewEmitter(false)
+
+	var fired int32
+	listener := e.AddListenerPattern("server.*.log", func(args ...interface{}) {
+		atomic.AddInt32(&fired, 1)
+	})
+
+	e.EmitEvent("server.one.log")
+	e.RemoveListenerPattern("server.*.log", listener)
+	e.EmitEvent("server.one.log")
+
+	if fired != 1 {
+		t.Errorf("expected pattern listener to be removed after RemoveListenerPattern, fired %d times", fired)
+	}
+}
+
+func TestEmitterPatternRecursive(t *testing.T) {
+	e := NewEmitter(false)
+
+	var rootFired, subFired int
+	e.AddListener("root.event", func(args ...interface{}) {
+		rootFired++
+		e.EmitEvent("sub.one.event", 1)
+		e.EmitEvent("sub.two.event", 2)
+	})
+
+	e.AddListenerPattern("sub.*.event", func(args ...interface{}) {
+		subFired++
+	})
+
+	e.EmitEvent("root.event")
+
+	if rootFired != 1 {
+		t.Errorf("expected root event to fire once, got %d", rootFired)
+	}
+	if subFired != 2 {
+		t.Errorf("expected pattern listener to fire twice for recursively emitted sub events, got %d", subFired)
+	}
+}
+
+func TestMatchPatternSegments(t *testing.T) {
+	tests := []struct {
+		pattern string
+		event   string
+		want    bool
+	}{
+		{"server.*.log", "server.one.log", true},
+		{"server.*.log", "server.one.two.log", false},
+		{"server.#", "server.one.two.log", true},
+		{"server.#", "server", true},
+		{"server.#", "other", false},
+		{"*.log", "server.log", true},
+		{"*.log", "log", false},
+	}
+
+	for _, tt := range tests {
+		got := matchPatternSegments(splitPattern(EventType(tt.pattern)), splitPattern(EventType(tt.event)))
+		if got != tt.want {
+			t.Errorf("matchPatternSegments(%q, %q) = %v, want %v", tt.pattern, tt.event, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package eventemitter
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestListenerPriorityOrdering(t *testing.T) {
+	e := NewEmitter(false)
+
+	var order []string
+	e.AddListenerWithPriority("test event", 1, func(args ...interface{}) Result {
+		order = append(order, "low")
+		return Continue
+	})
+	e.AddListenerWithPriority("test event", 10, func(args ...interface{}) Result {
+		order = append(order, "high")
+		return Continue
+	})
+	e.AddListener("test event", func(args ...interface{}) {
+		order = append(order, "default")
+	})
+	e.AddListenerWithPriority("test event", 10, func(args ...interface{}) Result {
+		order = append(order, "high2")
+		return Continue
+	})
+
+	e.EmitEvent("test event")
+
+	want := []string{"high", "high2", "low", "default"}
+	if len(order) != len(want) {
+		t.Fatalf("got %v, want %v", order, want)
+	}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Fatalf("got %v, want %v", order, want)
+		}
+	}
+}
+
+func TestListenerPriorityStopPropagation(t *testing.T) {
+	e := NewEmitter(false)
+
+	var order []string
+	e.AddListenerWithPriority("test event", 10, func(args ...interface{}) Result {
+		order = append(order, "first")
+		return StopPropagation
+	})
+	e.AddListenerWithPriority("test event", 5, func(args ...interface{}) Result {
+		order = append(order, "second")
+		return Continue
+	})
+
+	var captured bool
+	e.AddCapturer(func(event EventType, args ...interface{}) {
+		captured = true
+	})
+
+	e.EmitEvent("test event")
+
+	if len(order) != 1 || order[0] != "first" {
+		t.Fatalf("expected only the highest priority listener to fire, got %v", order)
+	}
+	if !captured {
+		t.Fatal("expected capturer to still fire when propagation is stopped")
+	}
+}
+
+func TestListenerPriorityAsyncAllFire(t *testing.T) {
+	e := NewEmitter(true)
+
+	var fired int32
+	e.AddListenerWithPriority("test event", 10, func(args ...interface{}) Result {
+		atomic.AddInt32(&fired, 1)
+		return StopPropagation
+	})
+	e.AddListenerWithPriority("test event", 5, func(args ...interface{}) Result {
+		atomic.AddInt32(&fired, 1)
+		return Continue
+	})
+
+	e.EmitEvent("test event")
+	time.Sleep(time.Millisecond * 100)
+
+	if atomic.LoadInt32(&fired) != 2 {
+		t.Fatalf("expected both async listeners to fire regardless of StopPropagation, got %d", atomic.LoadInt32(&fired))
+	}
+}
+
+func TestListenerPriorityMixedWithOnce(t *testing.T) {
+	e := NewEmitter(false)
+
+	var order []string
+	e.ListenOnce("test event", func(args ...interface{}) {
+		order = append(order, "once")
+	})
+	e.AddListenerWithPriority("test event", 10, func(args ...interface{}) Result {
+		order = append(order, "priority")
+		return Continue
+	})
+
+	e.EmitEvent("test event")
+
+	if len(order) != 2 || order[0] != "once" || order[1] != "priority" {
+		t.Fatalf("expected once listeners to fire before normal listeners, got %v", order)
+	}
+}
@@ -0,0 +1,160 @@
+package eventemitter
+
+import (
+	"errors"
+	"sync"
+)
+
+// DropPolicy determines what a Subscription does when its channel buffer is full.
+type DropPolicy int
+
+const (
+	// DropNewest drops the incoming event if the subscription's channel buffer is full.
+	DropNewest DropPolicy = iota
+	// DropOldest evicts the oldest buffered event to make room for the incoming one.
+	DropOldest
+	// Block blocks the delivering goroutine until there is room in the buffer. In an emitter running
+	// in sync mode this would deadlock the emit, so it instead falls back to DropNewest behaviour and
+	// surfaces the drop as an error on the subscription's ErrChan.
+	Block
+)
+
+// ErrSubscriptionChannelFull is sent on a Subscription's ErrChan when a Block-policy subscription on a
+// sync emitter cannot deliver an event without blocking the emitting goroutine.
+var ErrSubscriptionChannelFull = errors.New("eventemitter: subscription channel full, event dropped")
+
+// Event is a single emitted event as delivered over a Subscription's channel.
+type Event struct {
+	Type EventType
+	Args []interface{}
+}
+
+// Subscription is a channel-based subscription to one event type, or to all events when created via
+// SubscribeAll. Unsubscribe must be called to release the underlying listener or capturer.
+type Subscription struct {
+	// mu guards closed and synchronizes delivery against Unsubscribe closing ch: deliver holds a read
+	// lock for the duration of a send so Unsubscribe (which takes the write lock) can never close ch
+	// while a send to it is in flight.
+	mu       sync.RWMutex
+	emitter  *Emitter
+	event    EventType
+	policy   DropPolicy
+	ch       chan Event
+	errCh    chan error
+	listener *Listener
+	capturer *Capturer
+	closed   bool
+}
+
+// Subscribe returns a Subscription that receives Event values for the given event type on a channel
+// instead of invoking a callback. buffer sets the channel's capacity and policy controls what happens
+// when that capacity is exceeded.
+func (em *Emitter) Subscribe(event EventType, buffer int, policy DropPolicy) (sub *Subscription) {
+	sub = newSubscription(em, event, buffer, policy)
+	sub.listener = em.AddListener(event, func(arguments ...interface{}) {
+		sub.deliver(event, arguments)
+	})
+	return sub
+}
+
+// SubscribeAll returns a Subscription that receives an Event value, carrying its EventType, for every
+// event emitted by em, similar to AddCapturer.
+func (em *Emitter) SubscribeAll(buffer int, policy DropPolicy) (sub *Subscription) {
+	sub = newSubscription(em, "", buffer, policy)
+	sub.capturer = em.AddCapturer(func(event EventType, arguments ...interface{}) {
+		sub.deliver(event, arguments)
+	})
+	return sub
+}
+
+func newSubscription(em *Emitter, event EventType, buffer int, policy DropPolicy) *Subscription {
+	return &Subscription{
+		emitter: em,
+		event:   event,
+		policy:  policy,
+		ch:      make(chan Event, buffer),
+		errCh:   make(chan error, 1),
+	}
+}
+
+// deliver is registered as the Subscribe/SubscribeAll handler; it applies the configured DropPolicy
+// and must never be able to deadlock the emitter in sync mode.
+func (sub *Subscription) deliver(event EventType, arguments []interface{}) {
+	sub.mu.RLock()
+	defer sub.mu.RUnlock()
+
+	if sub.closed {
+		// Unsubscribe has already closed ch, sending on it would panic.
+		return
+	}
+
+	evt := Event{Type: event, Args: arguments}
+
+	switch sub.policy {
+	case DropOldest:
+		select {
+		case sub.ch <- evt:
+		default:
+			select {
+			case <-sub.ch:
+			default:
+			}
+			select {
+			case sub.ch <- evt:
+			default:
+				// Lost the race with another delivery, drop the incoming event.
+			}
+		}
+	case Block:
+		if sub.emitter.async {
+			sub.ch <- evt
+			return
+		}
+		// Blocking would deadlock the emitting goroutine in sync mode, fall back to DropNewest.
+		select {
+		case sub.ch <- evt:
+		default:
+			select {
+			case sub.errCh <- ErrSubscriptionChannelFull:
+			default:
+			}
+		}
+	default: // DropNewest
+		select {
+		case sub.ch <- evt:
+		default:
+		}
+	}
+}
+
+// Chan returns the channel that Event values are delivered on.
+func (sub *Subscription) Chan() <-chan Event {
+	return sub.ch
+}
+
+// ErrChan returns the channel that delivery errors are reported on, used by the Block policy when a
+// sync emitter would otherwise deadlock.
+func (sub *Subscription) ErrChan() <-chan error {
+	return sub.errCh
+}
+
+// Unsubscribe removes the underlying listener or capturer and closes the subscription's channel.
+func (sub *Subscription) Unsubscribe() {
+	// Remove from the emitter before taking sub.mu: a sync emit holds the emitter's lock while it calls
+	// deliver, so taking them in the opposite order here could deadlock against it.
+	if sub.listener != nil {
+		sub.emitter.RemoveListener(sub.event, sub.listener)
+	}
+	if sub.capturer != nil {
+		sub.emitter.RemoveCapturer(sub.capturer)
+	}
+
+	sub.mu.Lock()
+	defer sub.mu.Unlock()
+
+	if sub.closed {
+		return
+	}
+	sub.closed = true
+	close(sub.ch)
+}
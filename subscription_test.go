@@ -0,0 +1,147 @@
+package eventemitter
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestSubscribeBasic(t *testing.T) {
+	e := NewEmitter(false)
+	sub := e.Subscribe("test event", 4, DropNewest)
+	defer sub.Unsubscribe()
+
+	e.EmitEvent("test event", 1)
+	e.EmitEvent("test event", 2)
+
+	for _, want := range []int{1, 2} {
+		select {
+		case evt := <-sub.Chan():
+			if evt.Type != "test event" || evt.Args[0].(int) != want {
+				t.Fatalf("unexpected event %+v", evt)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("timed out waiting for event")
+		}
+	}
+}
+
+func TestSubscribeAll(t *testing.T) {
+	e := NewEmitter(false)
+	sub := e.SubscribeAll(4, DropNewest)
+	defer sub.Unsubscribe()
+
+	e.EmitEvent("event a", 1)
+	e.EmitEvent("event b", 2)
+
+	first := <-sub.Chan()
+	second := <-sub.Chan()
+
+	if first.Type != "event a" || second.Type != "event b" {
+		t.Fatalf("unexpected events %+v %+v", first, second)
+	}
+}
+
+func TestSubscribeDropNewest(t *testing.T) {
+	e := NewEmitter(false)
+	sub := e.Subscribe("test event", 1, DropNewest)
+	defer sub.Unsubscribe()
+
+	e.EmitEvent("test event", 1)
+	e.EmitEvent("test event", 2)
+
+	evt := <-sub.Chan()
+	if evt.Args[0].(int) != 1 {
+		t.Fatalf("expected oldest event to be kept, got %+v", evt)
+	}
+	select {
+	case evt := <-sub.Chan():
+		t.Fatalf("expected no further buffered events, got %+v", evt)
+	default:
+	}
+}
+
+func TestSubscribeDropOldest(t *testing.T) {
+	e := NewEmitter(false)
+	sub := e.Subscribe("test event", 1, DropOldest)
+	defer sub.Unsubscribe()
+
+	e.EmitEvent("test event", 1)
+	e.EmitEvent("test event", 2)
+
+	evt := <-sub.Chan()
+	if evt.Args[0].(int) != 2 {
+		t.Fatalf("expected newest event to be kept, got %+v", evt)
+	}
+}
+
+func TestSubscribeBlockSyncFallsBackWithError(t *testing.T) {
+	e := NewEmitter(false)
+	sub := e.Subscribe("test event", 1, Block)
+	defer sub.Unsubscribe()
+
+	e.EmitEvent("test event", 1)
+	e.EmitEvent("test event", 2)
+
+	select {
+	case err := <-sub.ErrChan():
+		if err != ErrSubscriptionChannelFull {
+			t.Fatalf("unexpected error %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected an error on ErrChan when the sync emitter could not block")
+	}
+}
+
+func TestSubscribeUnsubscribeDuringAsyncEmit(t *testing.T) {
+	for _, policy := range []DropPolicy{DropNewest, DropOldest, Block} {
+		policy := policy
+		t.Run("", func(t *testing.T) {
+			e := NewEmitter(true)
+			sub := e.Subscribe("test event", 1, policy)
+
+			var wg sync.WaitGroup
+			wg.Add(2)
+
+			go func() {
+				defer wg.Done()
+				for i := 0; i < 200; i++ {
+					e.EmitEvent("test event", i)
+				}
+			}()
+			go func() {
+				defer wg.Done()
+				sub.Unsubscribe()
+			}()
+
+			// Drain so a Block-policy send has somewhere to go and doesn't hang the test.
+			drain := make(chan struct{})
+			go func() {
+				defer close(drain)
+				for range sub.Chan() {
+				}
+			}()
+
+			wg.Wait()
+			select {
+			case <-drain:
+			case <-time.After(2 * time.Second):
+				t.Fatal("timed out draining subscription channel")
+			}
+		})
+	}
+}
+
+func TestSubscribeUnsubscribe(t *testing.T) {
+	e := NewEmitter(false)
+	sub := e.Subscribe("test event", 1, DropNewest)
+
+	sub.Unsubscribe()
+
+	e.EmitEvent("test event", 1)
+
+	_, ok := <-sub.Chan()
+	if ok {
+		t.Fatal("expected channel to be closed after Unsubscribe")
+	}
+}
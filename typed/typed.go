@@ -0,0 +1,171 @@
+// Package typed layers a compile-time-checked payload type per event on top of an *eventemitter.Emitter,
+// so callers can move away from `...interface{}` handlers while keeping the existing untyped API
+// (wildcard patterns, capturers, priorities) intact underneath.
+package typed
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	eventemitter "github.com/vansante/go-event-emitter"
+)
+
+// DispatchErrorFunc is called when a typed listener receives an argument that does not match its
+// expected type, registered via OnDispatchError.
+type DispatchErrorFunc func(event eventemitter.EventType, err error)
+
+// stateKey is the key this package stores its per-emitter state under in Emitter.Ext. Using an
+// unexported type as the key keeps it collision-free with other packages using the same extension point.
+type stateKey struct{}
+
+// state is the bookkeeping this package needs per *eventemitter.Emitter. It lives in that emitter's
+// Ext field instead of a package-global map, so it is garbage collected along with the emitter instead
+// of being retained for the lifetime of the process.
+type state struct {
+	mu          sync.Mutex
+	hook        DispatchErrorFunc
+	handlerType map[eventemitter.EventType][]reflect.Type
+}
+
+func stateFor(em *eventemitter.Emitter) *state {
+	if v, ok := em.Ext.Load(stateKey{}); ok {
+		return v.(*state)
+	}
+	actual, _ := em.Ext.LoadOrStore(stateKey{}, &state{handlerType: make(map[eventemitter.EventType][]reflect.Type)})
+	return actual.(*state)
+}
+
+// OnDispatchError registers handler to be called whenever a typed listener on em receives an argument
+// that fails its type assertion, instead of silently dropping it. Only one hook is kept per emitter;
+// registering again replaces the previous hook. Pass a nil handler to unregister it.
+func OnDispatchError(em *eventemitter.Emitter, handler DispatchErrorFunc) {
+	s := stateFor(em)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.hook = handler
+}
+
+func dispatchHook(em *eventemitter.Emitter) DispatchErrorFunc {
+	s := stateFor(em)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.hook
+}
+
+func reportDispatchError(em *eventemitter.Emitter, event eventemitter.EventType, err error) {
+	if hook := dispatchHook(em); hook != nil {
+		hook(event, err)
+	}
+}
+
+// registerHandlerType records that a typed handler expecting T has been registered for event on em, so
+// MustEmit can later tell whether a payload it is about to emit would be rejected by it.
+func registerHandlerType[T any](em *eventemitter.Emitter, event eventemitter.EventType) {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+
+	s := stateFor(em)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlerType[event] = append(s.handlerType[event], t)
+}
+
+// mismatchedHandlerType returns the type of a registered handler for event on em that payload type T
+// would not satisfy, if any.
+func mismatchedHandlerType[T any](em *eventemitter.Emitter, event eventemitter.EventType) (mismatch reflect.Type, found bool) {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+
+	s := stateFor(em)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, handlerType := range s.handlerType[event] {
+		if handlerType.Kind() == reflect.Interface && t.Implements(handlerType) {
+			continue
+		}
+		if handlerType != t {
+			return handlerType, true
+		}
+	}
+	return nil, false
+}
+
+// On registers a typed handler for event on em. The handler is only called when the emitted argument
+// is of type T; a mismatch is reported via OnDispatchError instead of invoking handler.
+func On[T any](em *eventemitter.Emitter, event eventemitter.EventType, handler func(T)) (listener *eventemitter.Listener) {
+	registerHandlerType[T](em, event)
+	return em.AddListener(event, wrapTyped(em, event, handler))
+}
+
+// Once registers a typed handler for event on em that removes itself after it has been fired once.
+func Once[T any](em *eventemitter.Emitter, event eventemitter.EventType, handler func(T)) (listener *eventemitter.Listener) {
+	registerHandlerType[T](em, event)
+	return em.ListenOnce(event, wrapTyped(em, event, handler))
+}
+
+// Emit emits event on em with a single, typed payload argument.
+func Emit[T any](em *eventemitter.Emitter, event eventemitter.EventType, payload T) {
+	em.EmitEvent(event, payload)
+}
+
+// MustEmit emits event on em like Emit, but panics if any typed listener registered for event expects a
+// payload type other than T, instead of reporting the mismatch via OnDispatchError. Listeners see the
+// exact same argument Emit would have delivered - MustEmit never wraps payload - so plain
+// AddListener handlers, capturers and pattern listeners on the same event are unaffected. It uses
+// EmitEventAndWait internally, so it waits for every listener - including ones dispatched on an async
+// worker pool - before deciding whether to panic. The check covers every handler type ever registered
+// for event via On or Once; a once-listener that has fired or a removed listener still counts.
+func MustEmit[T any](em *eventemitter.Emitter, event eventemitter.EventType, payload T) {
+	em.EmitEventAndWait(event, payload)
+
+	if mismatch, ok := mismatchedHandlerType[T](em, event); ok {
+		panic(fmt.Errorf("typed: argument for event %q is %T, not %s", event, payload, mismatch))
+	}
+}
+
+func wrapTyped[T any](em *eventemitter.Emitter, event eventemitter.EventType, handler func(T)) eventemitter.HandleFunc {
+	return func(arguments ...interface{}) {
+		if len(arguments) != 1 {
+			reportDispatchError(em, event, fmt.Errorf("typed: expected exactly 1 argument for event %q, got %d", event, len(arguments)))
+			return
+		}
+
+		payload, ok := arguments[0].(T)
+		if !ok {
+			reportDispatchError(em, event, fmt.Errorf("typed: argument for event %q is %T, not %T", event, arguments[0], payload))
+			return
+		}
+		handler(payload)
+	}
+}
+
+// TypedEmitter binds a single event and payload type to an *eventemitter.Emitter, for callers that
+// would rather not repeat the type parameter and event at every call site.
+type TypedEmitter[T any] struct {
+	em    *eventemitter.Emitter
+	event eventemitter.EventType
+}
+
+// NewTypedEmitter returns a TypedEmitter bound to the given emitter and event.
+func NewTypedEmitter[T any](em *eventemitter.Emitter, event eventemitter.EventType) *TypedEmitter[T] {
+	return &TypedEmitter[T]{em: em, event: event}
+}
+
+// On registers a typed handler for the bound event.
+func (te *TypedEmitter[T]) On(handler func(T)) (listener *eventemitter.Listener) {
+	return On[T](te.em, te.event, handler)
+}
+
+// Once registers a typed handler for the bound event that fires at most once.
+func (te *TypedEmitter[T]) Once(handler func(T)) (listener *eventemitter.Listener) {
+	return Once[T](te.em, te.event, handler)
+}
+
+// Emit emits the bound event with payload.
+func (te *TypedEmitter[T]) Emit(payload T) {
+	Emit[T](te.em, te.event, payload)
+}
+
+// MustEmit emits the bound event with payload, panicking on a typed listener mismatch.
+func (te *TypedEmitter[T]) MustEmit(payload T) {
+	MustEmit[T](te.em, te.event, payload)
+}
@@ -0,0 +1,214 @@
+package typed
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	eventemitter "github.com/vansante/go-event-emitter"
+)
+
+type userCreated struct {
+	ID   int
+	Name string
+}
+
+func TestOnAndEmit(t *testing.T) {
+	em := eventemitter.NewEmitter(false)
+
+	var got userCreated
+	On(em, "user.created", func(payload userCreated) {
+		got = payload
+	})
+
+	Emit(em, "user.created", userCreated{ID: 1, Name: "ada"})
+
+	if got.ID != 1 || got.Name != "ada" {
+		t.Fatalf("unexpected payload %+v", got)
+	}
+}
+
+func TestOnce(t *testing.T) {
+	em := eventemitter.NewEmitter(false)
+
+	fired := 0
+	Once(em, "user.created", func(payload userCreated) {
+		fired++
+	})
+
+	Emit(em, "user.created", userCreated{ID: 1})
+	Emit(em, "user.created", userCreated{ID: 2})
+
+	if fired != 1 {
+		t.Fatalf("expected typed once-listener to fire exactly once, got %d", fired)
+	}
+}
+
+func TestDispatchErrorOnTypeMismatch(t *testing.T) {
+	em := eventemitter.NewEmitter(false)
+
+	var reportedErr error
+	OnDispatchError(em, func(event eventemitter.EventType, err error) {
+		reportedErr = err
+	})
+	defer OnDispatchError(em, nil)
+
+	On(em, "user.created", func(payload userCreated) {
+		t.Fatal("handler should not be called for a mismatched payload type")
+	})
+
+	em.EmitEvent("user.created", "not a userCreated")
+
+	if reportedErr == nil {
+		t.Fatal("expected a dispatch error to be reported")
+	}
+}
+
+func TestMustEmitPanicsOnMismatch(t *testing.T) {
+	em := eventemitter.NewEmitter(false)
+
+	On(em, "user.created", func(payload userCreated) {
+		t.Fatal("handler should not be called for a mismatched payload type")
+	})
+
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Fatal("expected MustEmit to panic on a type mismatch")
+		}
+		if _, ok := r.(error); !ok {
+			t.Fatalf("expected panic value to be an error, got %T", r)
+		}
+	}()
+
+	MustEmit(em, "user.created", "not a userCreated")
+}
+
+func TestTypedEmitterBinding(t *testing.T) {
+	em := eventemitter.NewEmitter(false)
+	te := NewTypedEmitter[userCreated](em, "user.created")
+
+	var got userCreated
+	te.On(func(payload userCreated) {
+		got = payload
+	})
+
+	te.Emit(userCreated{ID: 7, Name: "grace"})
+
+	if got.ID != 7 || got.Name != "grace" {
+		t.Fatalf("unexpected payload %+v", got)
+	}
+}
+
+func TestOnDispatchErrorNilUnregistersHook(t *testing.T) {
+	em := eventemitter.NewEmitter(false)
+
+	calls := 0
+	OnDispatchError(em, func(event eventemitter.EventType, err error) {
+		calls++
+	})
+	OnDispatchError(em, nil)
+
+	On(em, "user.created", func(payload userCreated) {})
+	em.EmitEvent("user.created", "not a userCreated")
+
+	if calls != 0 {
+		t.Fatalf("expected no hook calls after unregistering, got %d", calls)
+	}
+}
+
+func TestMustEmitDeliversRealPayloadToPlainListeners(t *testing.T) {
+	em := eventemitter.NewEmitter(false)
+
+	On(em, "user.created", func(payload userCreated) {})
+
+	var captured interface{}
+	em.AddCapturer(func(event eventemitter.EventType, arguments ...interface{}) {
+		captured = arguments[0]
+	})
+
+	MustEmit(em, "user.created", userCreated{ID: 3, Name: "lin"})
+
+	got, ok := captured.(userCreated)
+	if !ok {
+		t.Fatalf("expected a capturer to see the real userCreated payload, got %T", captured)
+	}
+	if got.ID != 3 || got.Name != "lin" {
+		t.Fatalf("unexpected payload %+v", got)
+	}
+}
+
+func TestMustEmitDoesNotAffectConcurrentEmit(t *testing.T) {
+	em := eventemitter.NewEmitter(true)
+
+	On(em, "user.created", func(payload userCreated) {})
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	var panicked bool
+	go func() {
+		defer wg.Done()
+		defer func() {
+			if recover() != nil {
+				panicked = true
+			}
+		}()
+		for i := 0; i < 200; i++ {
+			Emit(em, "user.created", userCreated{ID: i})
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 200; i++ {
+			func() {
+				defer func() { recover() }()
+				MustEmit(em, "user.created", "mismatched payload")
+			}()
+		}
+	}()
+
+	wg.Wait()
+
+	if panicked {
+		t.Fatal("a concurrent, correctly-typed Emit must never panic because of an unrelated MustEmit call")
+	}
+}
+
+func TestMustEmitWaitsForAsyncListeners(t *testing.T) {
+	em := eventemitter.NewEmitterWithOptions(eventemitter.Options{Async: true, Workers: 2, QueueSize: 4})
+
+	On(em, "user.created", func(payload userCreated) {
+		time.Sleep(time.Millisecond * 20)
+	})
+
+	var plainDone int32
+	em.AddListener("user.created", func(arguments ...interface{}) {
+		time.Sleep(time.Millisecond * 20)
+		atomic.StoreInt32(&plainDone, 1)
+	})
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected MustEmit to panic once its async dispatch completes")
+		}
+		if atomic.LoadInt32(&plainDone) != 1 {
+			t.Fatal("expected MustEmit to wait for the async listener to finish before panicking")
+		}
+	}()
+
+	MustEmit(em, "user.created", "not a userCreated")
+}
+
+func TestReportDispatchErrorNoopWithoutHook(t *testing.T) {
+	em := eventemitter.NewEmitter(false)
+
+	On(em, "user.created", func(payload userCreated) {
+		t.Fatal("handler should not be called for a mismatched payload type")
+	})
+
+	// Should not panic even though no OnDispatchError hook was registered.
+	em.EmitEvent("user.created", 123)
+}